@@ -0,0 +1,185 @@
+package baseapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// BaseApp is the ABCI application boilerplate chains build their own
+// application on top of. This file carries the subset of BaseApp that the
+// lifecycle (shutdown.go), snapshot extension, and IBC conformance harness
+// subsystems in this package slice depend on: construction, the
+// snapshot/optimistic-execution options, and an IBC port router downstream
+// apps use to register their modules for the conformance handshake test.
+type BaseApp struct {
+	name      string
+	logger    log.Logger
+	db        dbm.DB
+	txDecoder sdk.TxDecoder
+
+	cms             storetypes.CommitMultiStore
+	snapshotManager SnapshotManager
+	optimisticExec  *optimisticExecution
+	ibcModules      map[string]porttypes.IBCModule
+
+	mu     sync.Mutex
+	sealed bool
+
+	shutdownOnce    sync.Once
+	shutdownErr     error
+	shutdownTimeout time.Duration
+	shutdownHooks   []ShutdownHook
+}
+
+// NewBaseApp returns a reference to an initialized BaseApp, applying the
+// given options in order.
+func NewBaseApp(
+	name string,
+	logger log.Logger,
+	db dbm.DB,
+	txDecoder sdk.TxDecoder,
+	options ...func(*BaseApp),
+) *BaseApp {
+	app := &BaseApp{
+		name:      name,
+		logger:    logger,
+		db:        db,
+		txDecoder: txDecoder,
+	}
+
+	for _, option := range options {
+		option(app)
+	}
+
+	return app
+}
+
+// Name returns the application's name.
+func (app *BaseApp) Name() string { return app.name }
+
+// Logger returns the application's logger.
+func (app *BaseApp) Logger() log.Logger { return app.logger }
+
+// CommitMultiStore returns the application's root multi-store.
+func (app *BaseApp) CommitMultiStore() storetypes.CommitMultiStore { return app.cms }
+
+// NewUncachedContext returns a fresh sdk.Context backed by the app's
+// multi-store, suitable for driving keeper calls (e.g. an IBC handshake)
+// outside of the normal ABCI request lifecycle.
+func (app *BaseApp) NewUncachedContext(isCheckTx bool, header cmtproto.Header) sdk.Context {
+	return sdk.NewContext(app.cms, header, isCheckTx, app.logger)
+}
+
+// RegisterIBCModule binds an IBC module to portID so the conformance
+// harness's mock relayer (and any real IBC core router a downstream app
+// wires up) can route channel handshake and packet callbacks to it.
+func (app *BaseApp) RegisterIBCModule(portID string, module porttypes.IBCModule) {
+	if app.ibcModules == nil {
+		app.ibcModules = make(map[string]porttypes.IBCModule)
+	}
+	app.ibcModules[portID] = module
+}
+
+// IBCModule returns the module registered for portID, if any.
+func (app *BaseApp) IBCModule(portID string) (porttypes.IBCModule, bool) {
+	module, ok := app.ibcModules[portID]
+	return module, ok
+}
+
+// SnapshotManager is the subset of *snapshots.Manager that Shutdown depends
+// on to let an in-progress snapshot operation settle (or be aborted) before
+// tearing down the rest of the app. It's declared as an interface, rather
+// than BaseApp depending on *snapshots.Manager directly, so tests can
+// substitute a fake that blocks deterministically instead of racing a real
+// snapshot operation against the store.
+type SnapshotManager interface {
+	WaitForOperationsToFinish()
+	AbortOperations() error
+	CloseExtensions() error
+}
+
+// SetSnapshot sets the snapshot store and options for the BaseApp, enabling
+// state-sync snapshot creation and restoration.
+func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if snapshotStore == nil {
+			return
+		}
+		app.snapshotManager = snapshots.NewManager(snapshotStore, opts, nil, nil, app.logger)
+	}
+}
+
+// SnapshotManager returns the app's snapshot manager, or nil if SetSnapshot
+// was never used.
+func (app *BaseApp) SnapshotManager() SnapshotManager { return app.snapshotManager }
+
+// SetSnapshotManagerForTesting overrides the app's snapshot manager with
+// mgr instead of constructing a real *snapshots.Manager, so tests can
+// exercise Shutdown's wait/abort logic against a fake that behaves
+// deterministically rather than racing a real snapshot operation. Production
+// wiring should use SetSnapshot.
+func SetSnapshotManagerForTesting(mgr SnapshotManager) func(*BaseApp) {
+	return func(app *BaseApp) { app.snapshotManager = mgr }
+}
+
+// SetOptimisticExecution enables optimistic execution of FinalizeBlock ahead
+// of the next block's proposal, overlapping block execution with consensus.
+func SetOptimisticExecution() func(*BaseApp) {
+	return func(app *BaseApp) { app.optimisticExec = newOptimisticExecution() }
+}
+
+// OptimisticallyExecute runs fn in the background the way FinalizeBlock
+// would run ahead of the next block's proposal, returning immediately.
+// Shutdown cancels fn's context and waits for it to return before
+// proceeding. It panics if SetOptimisticExecution was not used, the same way
+// calling it without the feature enabled would be a wiring bug.
+func (app *BaseApp) OptimisticallyExecute(fn func(ctx context.Context)) {
+	app.optimisticExec.execute(fn)
+}
+
+// optimisticExecution tracks the goroutine a BaseApp uses to run
+// FinalizeBlock ahead of the next proposal; Shutdown cancels and awaits it
+// so teardown never races a block still executing in the background.
+type optimisticExecution struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newOptimisticExecution() *optimisticExecution {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	close(done) // no execution in flight yet; Close must not block on it
+	return &optimisticExecution{ctx: ctx, cancel: cancel, done: done}
+}
+
+// execute runs fn in a new goroutine, tracking it so Close blocks until fn
+// returns.
+func (oe *optimisticExecution) execute(fn func(ctx context.Context)) {
+	done := make(chan struct{})
+	oe.done = done
+	go func() {
+		defer close(done)
+		fn(oe.ctx)
+	}()
+}
+
+// Close cancels any in-flight optimistic execution and waits for its
+// goroutine to return.
+func (oe *optimisticExecution) Close() {
+	oe.cancel()
+	<-oe.done
+}
@@ -1,11 +1,14 @@
 package baseapp_test
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
-	coretesting "cosmossdk.io/core/testing" 
+	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -95,4 +98,115 @@ func TestBaseApp_CloseWithAllComponents(t *testing.T) {
 	// Test closing app with all components
 	err = app.Close()
 	require.NoError(t, err, "Close() with all components should not error")
-}
\ No newline at end of file
+}
+
+// fakeSnapshotManager is a baseapp.SnapshotManager that stays "in progress"
+// until the test signals it, so TestBaseApp_ShutdownWhileSnapshotInProgress
+// can deterministically exercise Shutdown's wait/abort path instead of
+// racing a real snapshot operation against the snapshot store.
+type fakeSnapshotManager struct {
+	release chan struct{}
+	aborted chan struct{}
+	closed  chan struct{}
+}
+
+func newFakeSnapshotManager() *fakeSnapshotManager {
+	return &fakeSnapshotManager{
+		release: make(chan struct{}),
+		aborted: make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (m *fakeSnapshotManager) WaitForOperationsToFinish() { <-m.release }
+
+func (m *fakeSnapshotManager) AbortOperations() error {
+	close(m.aborted)
+	close(m.release)
+	return nil
+}
+
+func (m *fakeSnapshotManager) CloseExtensions() error {
+	close(m.closed)
+	return nil
+}
+
+func TestBaseApp_ShutdownWhileSnapshotInProgress(t *testing.T) {
+	// Create a test database
+	db := coretesting.NewMemDB()
+	defer db.Close()
+
+	logger := log.NewNopLogger()
+
+	var hookRan sync.WaitGroup
+	hookRan.Add(1)
+
+	mgr := newFakeSnapshotManager()
+
+	app := baseapp.NewBaseApp("test", logger, db, nil,
+		baseapp.SetSnapshotManagerForTesting(mgr),
+		baseapp.SetShutdownTimeout(100*time.Millisecond),
+		baseapp.AddShutdownHook(func(context.Context) error {
+			hookRan.Done()
+			return nil
+		}),
+	)
+
+	// The snapshot operation never finishes on its own, so Close (which
+	// bounds Shutdown by the configured shutdownTimeout) must hit that
+	// 100ms timeout, abort it, and still proceed to run shutdown hooks and
+	// close extensions rather than blocking forever.
+	start := time.Now()
+	err := app.Close()
+	require.NoError(t, err, "Close() during a snapshot operation should not error")
+	require.Less(t, time.Since(start), time.Second, "Close() should have aborted at shutdownTimeout, not blocked on it")
+
+	select {
+	case <-mgr.aborted:
+	default:
+		t.Fatal("Shutdown() did not abort the in-progress snapshot operation")
+	}
+	select {
+	case <-mgr.closed:
+	default:
+		t.Fatal("Shutdown() did not close snapshot extensions")
+	}
+	hookRan.Wait()
+}
+
+func TestBaseApp_ShutdownWhileOptimisticExecutionInProgress(t *testing.T) {
+	// Create a test database
+	db := coretesting.NewMemDB()
+	defer db.Close()
+
+	logger := log.NewNopLogger()
+
+	app := baseapp.NewBaseApp("test", logger, db, nil, baseapp.SetOptimisticExecution())
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	app.OptimisticallyExecute(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(finished)
+	})
+	<-started
+
+	// Shutdown should cancel and await the in-flight optimistic execution
+	// goroutine before closing the DB.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := app.Shutdown(ctx)
+	require.NoError(t, err, "Shutdown() during optimistic execution should not error")
+
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Shutdown() returned before the optimistic execution goroutine finished")
+	}
+
+	// Shutdown must be idempotent.
+	err = app.Shutdown(ctx)
+	require.NoError(t, err, "Shutdown() should be safe to call multiple times")
+}
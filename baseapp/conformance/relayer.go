@@ -0,0 +1,171 @@
+package conformance
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// mockRelayer wires two in-process BaseApp instances together well enough
+// to drive an IBC channel handshake without a real network or light
+// client, mirroring what the Go relayer's CreateChannel does against two
+// live chains. It routes the four handshake callbacks to whatever IBC
+// module each chain registered under the given port via
+// baseapp.BaseApp.RegisterIBCModule, so the handshake actually exercises
+// the app's own IBCModule rather than faking success.
+type mockRelayer struct {
+	chainA, chainB *baseapp.BaseApp
+	channels       map[string]*Channel
+}
+
+func newMockRelayer(chainA, chainB *baseapp.BaseApp) *mockRelayer {
+	return &mockRelayer{chainA: chainA, chainB: chainB, channels: map[string]*Channel{}}
+}
+
+// Channel is the result of a (possibly re-run) channel handshake.
+type Channel struct {
+	ChannelID string
+	PortA     string
+	PortB     string
+	state     string
+}
+
+// Open reports whether the channel handshake reached OPEN.
+func (c *Channel) Open() bool { return c.state == "OPEN" }
+
+// CreateChannelOption configures a single CreateChannel call.
+type CreateChannelOption func(*createChannelConfig)
+
+type createChannelConfig struct {
+	override bool
+}
+
+// WithOverride re-runs the handshake against an already-open channel
+// instead of erroring, matching the --override flag added to the Go
+// relayer's CreateChannel.
+func WithOverride() CreateChannelOption {
+	return func(c *createChannelConfig) { c.override = true }
+}
+
+// CreateChannel runs OnChanOpenInit/Try/Ack/Confirm between portA (on
+// chainA) and portB (on chainB). Called a second time for the same ports
+// without WithOverride, it returns an error; with WithOverride, it
+// re-opens the existing channel end instead by re-running OnChanOpenConfirm
+// on chainB.
+func (r *mockRelayer) CreateChannel(t *testing.T, portA, portB string, opts ...CreateChannelOption) (*Channel, error) {
+	t.Helper()
+
+	cfg := &createChannelConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	key := portA + "<->" + portB
+	if existing, ok := r.channels[key]; ok {
+		if !cfg.override {
+			return nil, fmt.Errorf("channel %s already open, pass WithOverride() to re-open it", key)
+		}
+
+		moduleB, ok := r.chainB.IBCModule(portB)
+		if !ok {
+			return nil, fmt.Errorf("chainB has no IBC module registered for port %q", portB)
+		}
+		if err := moduleB.OnChanOpenConfirm(r.chainB.NewUncachedContext(false, cmtproto.Header{}), portB, existing.ChannelID); err != nil {
+			return nil, fmt.Errorf("re-confirm channel %s: %w", key, err)
+		}
+
+		existing.state = "OPEN"
+		return existing, nil
+	}
+
+	moduleA, ok := r.chainA.IBCModule(portA)
+	if !ok {
+		return nil, fmt.Errorf("chainA has no IBC module registered for port %q", portA)
+	}
+	moduleB, ok := r.chainB.IBCModule(portB)
+	if !ok {
+		return nil, fmt.Errorf("chainB has no IBC module registered for port %q", portB)
+	}
+
+	channelID := fmt.Sprintf("channel-%d", len(r.channels))
+	chanCap := &capabilitytypes.Capability{Index: uint64(len(r.channels))}
+	ctxA := r.chainA.NewUncachedContext(false, cmtproto.Header{ChainID: r.chainA.Name()})
+	ctxB := r.chainB.NewUncachedContext(false, cmtproto.Header{ChainID: r.chainB.Name()})
+
+	initVersion, err := moduleA.OnChanOpenInit(
+		ctxA,
+		channeltypes.UNORDERED,
+		[]string{"connection-0"},
+		portA,
+		channelID,
+		chanCap,
+		channeltypes.NewCounterparty(portB, ""),
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("OnChanOpenInit on chainA: %w", err)
+	}
+
+	tryVersion, err := moduleB.OnChanOpenTry(
+		ctxB,
+		channeltypes.UNORDERED,
+		[]string{"connection-0"},
+		portB,
+		channelID,
+		chanCap,
+		channeltypes.NewCounterparty(portA, channelID),
+		initVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("OnChanOpenTry on chainB: %w", err)
+	}
+
+	if err := moduleA.OnChanOpenAck(ctxA, portA, channelID, channelID, tryVersion); err != nil {
+		return nil, fmt.Errorf("OnChanOpenAck on chainA: %w", err)
+	}
+
+	if err := moduleB.OnChanOpenConfirm(ctxB, portB, channelID); err != nil {
+		return nil, fmt.Errorf("OnChanOpenConfirm on chainB: %w", err)
+	}
+
+	channel := &Channel{
+		ChannelID: channelID,
+		PortA:     portA,
+		PortB:     portB,
+		state:     "OPEN",
+	}
+	r.channels[key] = channel
+	return channel, nil
+}
+
+// chunksOf reads back every chunk of snapshot from store and returns them as
+// a channel suitable for snapshots.Store.Restore, the same shape the real
+// state-sync reactor feeds chunks through as they arrive from peers.
+func chunksOf(t *testing.T, store *snapshots.Store, snapshot *snapshottypes.Snapshot) <-chan io.ReadCloser {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			reader, err := store.LoadChunk(snapshot.Height, snapshot.Format, i)
+			if err != nil {
+				t.Errorf("load chunk %d: %v", i, err)
+				return
+			}
+			ch <- reader
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,123 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultShutdownTimeout is the deadline Close gives Shutdown when
+// SetShutdownTimeout was not used: how long Shutdown waits for an
+// in-progress snapshot operation before aborting it. In-flight optimistic
+// execution is cancelled at once and is not bounded by this timeout.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ShutdownHook is invoked during Shutdown after optimistic execution and any
+// active snapshot operation have finished (or been aborted on ctx's
+// deadline), but before the DB is closed. Shutdown has no separate
+// multistore flush step; the DB is closed directly after the hooks.
+// Module authors register these via AddShutdownHook to release their own
+// resources in the same ordered teardown.
+type ShutdownHook func(context.Context) error
+
+// SetShutdownTimeout sets the deadline Close gives Shutdown: how long it
+// waits for an in-progress snapshot to finish before aborting it and
+// proceeding with the rest of the shutdown sequence. Optimistic execution is
+// cancelled at once regardless of this timeout. Defaults to
+// DefaultShutdownTimeout.
+func SetShutdownTimeout(timeout time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.shutdownTimeout = timeout }
+}
+
+// AddShutdownHook registers a teardown function to run during Shutdown,
+// after the app has stopped accepting new CheckTx/FinalizeBlock calls and
+// any in-flight optimistic execution or snapshot operation has settled, but
+// before the DB is closed. Hooks run in
+// registration order; a hook's error is joined into Shutdown's return value
+// but does not stop later hooks from running.
+func AddShutdownHook(hook ShutdownHook) func(*BaseApp) {
+	return func(app *BaseApp) { app.shutdownHooks = append(app.shutdownHooks, hook) }
+}
+
+// Shutdown performs an ordered graceful shutdown of the BaseApp:
+//
+//  1. stops accepting new CheckTx/FinalizeBlock calls
+//  2. cancels and awaits any in-progress optimistic execution
+//  3. waits for the snapshot manager to finish an active snapshot
+//     creation/restoration, or aborts it cleanly on ctx's deadline
+//  4. runs registered shutdown hooks, in registration order
+//  5. closes the DB last
+//
+// It is safe to call multiple times; only the first call performs the
+// teardown, and later calls return the same result. Close calls Shutdown
+// with a context bounded by shutdownTimeout; call Shutdown directly to
+// control cancellation from the caller side, e.g. to honor a process-wide
+// SIGTERM deadline.
+func (app *BaseApp) Shutdown(ctx context.Context) error {
+	app.shutdownOnce.Do(func() {
+		app.mu.Lock()
+		app.sealed = true
+		app.mu.Unlock()
+
+		if app.optimisticExec != nil {
+			app.optimisticExec.Close()
+		}
+
+		if app.snapshotManager != nil {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				app.snapshotManager.WaitForOperationsToFinish()
+			}()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+				if err := app.snapshotManager.AbortOperations(); err != nil {
+					app.shutdownErr = errors.Join(app.shutdownErr, fmt.Errorf("abort snapshot operations: %w", err))
+				}
+				<-done
+			}
+
+			if err := app.snapshotManager.CloseExtensions(); err != nil {
+				app.shutdownErr = errors.Join(app.shutdownErr, fmt.Errorf("close snapshot extensions: %w", err))
+			}
+		}
+
+		for _, hook := range app.shutdownHooks {
+			if err := hook(ctx); err != nil {
+				app.shutdownErr = errors.Join(app.shutdownErr, fmt.Errorf("shutdown hook: %w", err))
+			}
+		}
+
+		if app.db != nil {
+			if err := app.db.Close(); err != nil {
+				app.shutdownErr = errors.Join(app.shutdownErr, fmt.Errorf("close db: %w", err))
+			}
+		}
+	})
+
+	return app.shutdownErr
+}
+
+// shutdownContext returns the context Close uses to bound Shutdown, falling
+// back to DefaultShutdownTimeout when SetShutdownTimeout was not used.
+func (app *BaseApp) shutdownContext() (context.Context, context.CancelFunc) {
+	timeout := app.shutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// Close is called in order to gracefully stop the BaseApp, running the same
+// ordered teardown as Shutdown but bounded by shutdownTimeout instead of a
+// caller-supplied context. It is part of the servertypes.Application
+// interface and is safe to call multiple times.
+func (app *BaseApp) Close() error {
+	ctx, cancel := app.shutdownContext()
+	defer cancel()
+
+	return app.Shutdown(ctx)
+}
@@ -1,164 +1,63 @@
 package polkadotbridge
 
 import (
-	"encoding/json"
 	"fmt"
-	"unsafe"
 
-	"github.com/cosmos/cosmos-sdk/types"
-)
-
-/*
-#cgo LDFLAGS: -L./target/release -lpolkadot_compat
-#include <stdint.h>
-#include <stdlib.h>
-
-// Forward declarations for the Rust functions
-extern char* create_cross_chain_transaction(char* source_chain, char* dest_chain, char* payload, uint32_t payload_len);
-extern int32_t validate_cosmos_address(char* address);
-extern int32_t validate_polkadot_address(char* address);
-extern void free_rust_string(char* ptr);
-*/
-import "C"
-
-// CrossChainTransaction represents a transaction between Cosmos and Polkadot
-type CrossChainTransaction struct {
-	SourceChain string `json:"source_chain"`
-	DestChain   string `json:"dest_chain"`
-	Payload     []byte `json:"payload"`
-	Hash        string `json:"hash"`
-}
-
-// BridgeConfig holds the configuration for the Cosmos-Polkadot bridge
-type BridgeConfig struct {
-	CosmosChainID   string  `json:"cosmos_chain_id"`
-	PolkadotChainID uint32  `json:"polkadot_chain_id"`
-	BridgeAddress   *string `json:"bridge_address,omitempty"`
-}
-
-// TransactionStatus represents the status of a cross-chain transaction
-type TransactionStatus int
+	"github.com/cosmos/gogoproto/proto"
 
-const (
-	StatusInitiated TransactionStatus = iota
-	StatusPending
-	StatusCompleted
-	StatusFailed
+	"github.com/cosmos/cosmos-sdk/types"
 )
 
-// PolkadotBridge provides integration between Cosmos SDK and Polkadot
+// PolkadotBridge provides integration between Cosmos SDK and Polkadot. The
+// actual address-validation and cross-chain-transaction-construction calls
+// cross an FFI boundary into a Rust implementation; see keeper_cgo.go and
+// keeper_no_cgo.go for the two build-tagged implementations of that
+// boundary.
 type PolkadotBridge struct {
 	config BridgeConfig
 }
 
-// NewPolkadotBridge creates a new instance of the Polkadot bridge
+// NewPolkadotBridge creates a new instance of the Polkadot bridge.
 func NewPolkadotBridge(config BridgeConfig) *PolkadotBridge {
 	return &PolkadotBridge{
 		config: config,
 	}
 }
 
-// CreateCrossChainTransaction creates a new cross-chain transaction
-func (b *PolkadotBridge) CreateCrossChainTransaction(sourceChain, destChain string, payload []byte) (*CrossChainTransaction, error) {
-	sourceChainC := C.CString(sourceChain)
-	destChainC := C.CString(destChain)
-	defer C.free(unsafe.Pointer(sourceChainC))
-	defer C.free(unsafe.Pointer(destChainC))
-
-	payloadC := C.CBytes(payload)
-	defer C.free(payloadC)
-
-	resultC := C.create_cross_chain_transaction(
-		sourceChainC,
-		destChainC,
-		(*C.char)(payloadC),
-		C.uint32_t(len(payload)),
-	)
-
-	if resultC == nil {
-		return nil, fmt.Errorf("failed to create cross-chain transaction")
-	}
-
-	result := C.GoString(resultC)
-	C.free_rust_string(resultC)
-
-	var tx CrossChainTransaction
-	if err := json.Unmarshal([]byte(result), &tx); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal transaction: %w", err)
-	}
-
-	return &tx, nil
-}
-
-// ValidateCosmosAddress validates a Cosmos address using the Rust implementation
-func (b *PolkadotBridge) ValidateCosmosAddress(address string) bool {
-	addressC := C.CString(address)
-	defer C.free(unsafe.Pointer(addressC))
-
-	result := C.validate_cosmos_address(addressC)
-	return result != 0
-}
-
-// ValidatePolkadotAddress validates a Polkadot address using the Rust implementation
-func (b *PolkadotBridge) ValidatePolkadotAddress(address string) bool {
-	addressC := C.CString(address)
-	defer C.free(unsafe.Pointer(addressC))
-
-	result := C.validate_polkadot_address(addressC)
-	return result != 0
-}
-
-// GetConfig returns the bridge configuration
+// GetConfig returns the bridge configuration.
 func (b *PolkadotBridge) GetConfig() BridgeConfig {
 	return b.config
 }
 
-// ProcessCosmosTransaction processes a Cosmos SDK transaction for cross-chain transfer
+// ProcessCosmosTransaction processes a Cosmos SDK transaction for
+// cross-chain transfer.
 func (b *PolkadotBridge) ProcessCosmosTransaction(tx types.Tx, targetChain string) (*CrossChainTransaction, error) {
-	// Serialize the Cosmos transaction
-	txBytes, err := json.Marshal(tx)
+	protoTx, ok := tx.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cosmos transaction does not implement proto.Message")
+	}
+
+	txBytes, err := proto.Marshal(protoTx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal cosmos transaction: %w", err)
 	}
 
-	// Create cross-chain transaction
-	return b.CreateCrossChainTransaction(
-		b.config.CosmosChainID,
-		targetChain,
-		txBytes,
-	)
+	return b.CreateCrossChainTransaction(b.config.CosmosChainID, targetChain, txBytes)
 }
 
-// Helper function to integrate with Cosmos SDK modules
-func (b *PolkadotBridge) RegisterWithCosmosSDK() error {
-	// This would register the bridge with the Cosmos SDK module manager
-	// Implementation would depend on the specific module integration
-	return nil
+// ValidateCounterpartyAddress implements the polkadotbridge/types.RelayerAdapter
+// interface. The IBC module in x/polkadot-bridge/keeper consults this on
+// OnRecvPacket, so a chain can swap in a different light-client adapter
+// without touching this file's FFI boundary.
+func (b *PolkadotBridge) ValidateCounterpartyAddress(chainID, addr string) bool {
+	return b.ValidatePolkadotAddress(addr)
 }
 
-// CosmosSDKModule represents the Polkadot bridge as a Cosmos SDK module
-type CosmosSDKModule struct {
-	bridge *PolkadotBridge
+// IsAuthorizedRelayer implements the polkadotbridge/types.RelayerAdapter
+// interface. Only the configured bridge address may resolve transfers
+// out-of-band via MsgAcknowledgeTransfer; there is no allow-list here
+// because this adapter fronts a single trusted relayer process, not a set
+// of them.
+func (b *PolkadotBridge) IsAuthorizedRelayer(addr string) bool {
+	return b.config.BridgeAddress != "" && addr == b.config.BridgeAddress
 }
-
-// NewCosmosSDKModule creates a new Cosmos SDK module for Polkadot integration
-func NewCosmosSDKModule(config BridgeConfig) *CosmosSDKModule {
-	return &CosmosSDKModule{
-		bridge: NewPolkadotBridge(config),
-	}
-}
-
-// Name returns the module name
-func (m *CosmosSDKModule) Name() string {
-	return "polkadot-bridge"
-}
-
-// Route returns the message route
-func (m *CosmosSDKModule) Route() types.Route {
-	return types.NewRoute("polkadot", nil)
-}
-
-// GetBridge returns the underlying bridge instance
-func (m *CosmosSDKModule) GetBridge() *PolkadotBridge {
-	return m.bridge
-}
\ No newline at end of file
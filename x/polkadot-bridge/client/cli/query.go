@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// NewQueryCmd returns the top-level command for polkadotbridge queries.
+func NewQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the polkadotbridge module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewQueryPendingTransferCmd(),
+		NewQueryCompletedTransferCmd(),
+	)
+
+	return cmd
+}
+
+// NewQueryPendingTransferCmd returns a CLI command to look up a pending
+// transfer by channel and sequence.
+func NewQueryPendingTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfer [port-id] [channel-id] [sequence]",
+		Short: "Query a pending cross-chain transfer by channel and sequence",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingTransfer(cmd.Context(), &types.QueryPendingTransferRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCompletedTransferCmd returns a CLI command to look up a completed
+// transfer by channel and sequence.
+func NewQueryCompletedTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completed-transfer [port-id] [channel-id] [sequence]",
+		Short: "Query a completed cross-chain transfer by channel and sequence",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CompletedTransfer(cmd.Context(), &types.QueryCompletedTransferRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
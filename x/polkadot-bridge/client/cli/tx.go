@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// NewTxCmd returns the top-level command for polkadotbridge transactions.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "polkadotbridge transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewInitiateTransferCmd())
+
+	return cmd
+}
+
+// NewInitiateTransferCmd returns a CLI command to submit a
+// MsgInitiateTransfer.
+func NewInitiateTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "initiate-transfer [src-port] [src-channel] [dest-chain] [payload] [timeout-height]",
+		Short: "Initiate a cross-chain transfer to Polkadot over an IBC channel",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgInitiateTransfer{
+				Sender:        clientCtx.GetFromAddress().String(),
+				SourcePort:    args[0],
+				SourceChannel: args[1],
+				DestChain:     args[2],
+				Payload:       []byte(args[3]),
+				TimeoutHeight: timeoutHeight,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
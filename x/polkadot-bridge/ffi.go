@@ -0,0 +1,93 @@
+package polkadotbridge
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Code normally generated from proto/polkadotbridge/v1/ffi.proto via
+// `make proto-gen`; hand-maintained here until protoc-gen-gocosmos is wired
+// into this module's build.
+
+// CrossChainTransactionRequest is the typed request marshaled to bytes and
+// passed across the FFI boundary, replacing the old ad-hoc JSON blob.
+type CrossChainTransactionRequest struct {
+	SourceChain string `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	DestChain   string `protobuf:"bytes,2,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain,omitempty"`
+	Payload     []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *CrossChainTransactionRequest) Reset()         { *m = CrossChainTransactionRequest{} }
+func (m *CrossChainTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CrossChainTransactionRequest) ProtoMessage()    {}
+
+// CrossChainTransaction represents a transaction between Cosmos and
+// Polkadot, returned by the Rust implementation over the FFI boundary.
+type CrossChainTransaction struct {
+	SourceChain string `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	DestChain   string `protobuf:"bytes,2,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain,omitempty"`
+	Payload     []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Hash        string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *CrossChainTransaction) Reset()         { *m = CrossChainTransaction{} }
+func (m *CrossChainTransaction) String() string { return proto.CompactTextString(m) }
+func (*CrossChainTransaction) ProtoMessage()    {}
+
+// BridgeConfig holds the configuration for the Cosmos-Polkadot bridge.
+type BridgeConfig struct {
+	CosmosChainID   string `protobuf:"bytes,1,opt,name=cosmos_chain_id,json=cosmosChainId,proto3" json:"cosmos_chain_id,omitempty"`
+	PolkadotChainID uint32 `protobuf:"varint,2,opt,name=polkadot_chain_id,json=polkadotChainId,proto3" json:"polkadot_chain_id,omitempty"`
+	BridgeAddress   string `protobuf:"bytes,3,opt,name=bridge_address,json=bridgeAddress,proto3" json:"bridge_address,omitempty"`
+}
+
+func (m *BridgeConfig) Reset()         { *m = BridgeConfig{} }
+func (m *BridgeConfig) String() string { return proto.CompactTextString(m) }
+func (*BridgeConfig) ProtoMessage()    {}
+
+// TransactionStatus represents the status of a cross-chain transaction.
+type TransactionStatus int
+
+const (
+	StatusInitiated TransactionStatus = iota
+	StatusPending
+	StatusCompleted
+	StatusFailed
+)
+
+// maxFFIPayloadLen bounds the size of a single request/response crossing
+// the FFI boundary. The Rust side rejects anything larger, but we check
+// first on the Go side so an oversized payload fails fast with a normal Go
+// error instead of depending on the extern call to validate it.
+const maxFFIPayloadLen = 1 << 20 // 1 MiB
+
+// ffiErrCode is the error-code out-parameter every extern FFI call fills in,
+// alongside its length-prefixed byte-buffer result.
+type ffiErrCode int32
+
+const (
+	ffiOK ffiErrCode = 0
+	// ffiErrInvalidInput means the Rust side rejected the request as
+	// invalid (e.g. an oversized payload).
+	ffiErrInvalidInput ffiErrCode = 1
+	// ffiErrRustPanic means the Rust side recovered from a panic while
+	// servicing the call and produced no usable result.
+	ffiErrRustPanic ffiErrCode = 2
+	// ffiErrEncoding means the Rust side failed to encode its result as
+	// the expected protobuf message.
+	ffiErrEncoding ffiErrCode = 3
+)
+
+func (c ffiErrCode) String() string {
+	switch c {
+	case ffiOK:
+		return "ok"
+	case ffiErrInvalidInput:
+		return "invalid input"
+	case ffiErrRustPanic:
+		return "rust panic"
+	case ffiErrEncoding:
+		return "encoding error"
+	default:
+		return "unknown FFI error"
+	}
+}
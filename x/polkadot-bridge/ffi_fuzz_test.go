@@ -0,0 +1,47 @@
+package polkadotbridge
+
+import (
+	"testing"
+)
+
+// FuzzCreateCrossChainTransaction exercises the Go-side of the FFI boundary
+// with arbitrary source/dest chain strings and payloads, guarding against
+// the maxFFIPayloadLen check regressing. It does not require the Rust
+// library: without -tags polkadot_ffi this hits the stub in
+// keeper_no_cgo.go, which applies the same length check as the real
+// implementation in keeper_cgo.go before returning errFFIUnavailable, so the
+// check it fuzzes runs the same way on both build paths.
+func FuzzCreateCrossChainTransaction(f *testing.F) {
+	f.Add("cosmoshub-4", "polkadot", []byte("payload"))
+	f.Add("", "", []byte(nil))
+	f.Add("cosmoshub-4", "polkadot", make([]byte, maxFFIPayloadLen+1))
+
+	bridge := NewPolkadotBridge(BridgeConfig{CosmosChainID: "cosmoshub-4", PolkadotChainID: 0})
+
+	f.Fuzz(func(t *testing.T, sourceChain, destChain string, payload []byte) {
+		tx, err := bridge.CreateCrossChainTransaction(sourceChain, destChain, payload)
+		if err != nil {
+			if tx != nil {
+				t.Fatalf("expected nil transaction on error, got %+v", tx)
+			}
+			return
+		}
+		if len(payload) > maxFFIPayloadLen {
+			t.Fatalf("expected an error for an oversized payload of %d bytes", len(payload))
+		}
+	})
+}
+
+// FuzzValidateCosmosAddress guards against the address-validation FFI calls
+// panicking on malformed or adversarial input.
+func FuzzValidateCosmosAddress(f *testing.F) {
+	f.Add("cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzyyzrs")
+	f.Add("")
+	f.Add(string(make([]byte, 4096)))
+
+	bridge := NewPolkadotBridge(BridgeConfig{})
+
+	f.Fuzz(func(t *testing.T, address string) {
+		_ = bridge.ValidateCosmosAddress(address)
+	})
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// InitGenesis binds the module's port (if not already bound via capability)
+// and restores pending and completed transfers from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if _, found := k.scopedKeeper.GetCapability(ctx, hostPortChannelCapabilityName(genState.PortId, "")); !found {
+		cap := k.portKeeper.BindPort(ctx, genState.PortId)
+		if err := k.scopedKeeper.ClaimCapability(ctx, cap, hostPortChannelCapabilityName(genState.PortId, "")); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, transfer := range genState.PendingTransfers {
+		if err := k.SetPendingTransfer(ctx, transfer); err != nil {
+			panic(err)
+		}
+	}
+	for _, transfer := range genState.CompletedTransfers {
+		if err := k.SetCompletedTransfer(ctx, transfer); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := types.DefaultGenesis()
+
+	if err := k.IteratePendingTransfers(ctx, func(t types.Transfer) bool {
+		genState.PendingTransfers = append(genState.PendingTransfers, t)
+		return true
+	}); err != nil {
+		panic(err)
+	}
+	if err := k.IterateCompletedTransfers(ctx, func(t types.Transfer) bool {
+		genState.CompletedTransfers = append(genState.CompletedTransfers, t)
+		return true
+	}); err != nil {
+		panic(err)
+	}
+
+	return genState
+}
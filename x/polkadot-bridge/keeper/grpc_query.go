@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// PendingTransfer implements types.QueryServer.
+func (k Keeper) PendingTransfer(ctx context.Context, req *types.QueryPendingTransferRequest) (*types.QueryPendingTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	transfer, found, err := k.GetPendingTransfer(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no pending transfer on port %q channel %q sequence %d", req.PortId, req.ChannelId, req.Sequence))
+	}
+
+	return &types.QueryPendingTransferResponse{Transfer: &transfer}, nil
+}
+
+// CompletedTransfer implements types.QueryServer.
+func (k Keeper) CompletedTransfer(ctx context.Context, req *types.QueryCompletedTransferRequest) (*types.QueryCompletedTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	transfer, found, err := k.GetCompletedTransfer(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no completed transfer on port %q channel %q sequence %d", req.PortId, req.ChannelId, req.Sequence))
+	}
+
+	return &types.QueryCompletedTransferResponse{Transfer: &transfer}, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// IBCModule implements the ibc-go porttypes.IBCModule interface for the
+// polkadotbridge module. Every cross-chain transfer travels as one IBC
+// packet on a channel bound to types.PortID, giving it the same replay
+// protection, ordering, and timeout guarantees as ICS-20 transfers.
+type IBCModule struct {
+	keeper Keeper
+}
+
+// NewIBCModule creates a new IBCModule for the polkadotbridge module.
+func NewIBCModule(k Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.PortID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, types.PortID)
+	}
+	if version != "" && version != Version {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "expected %s, got %s", Version, version)
+	}
+
+	if err := im.keeper.scopedKeeper.ClaimCapability(ctx, chanCap, hostPortChannelCapabilityName(portID, channelID)); err != nil {
+		return "", errorsmod.Wrap(err, "claim channel capability")
+	}
+
+	return Version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if counterpartyVersion != Version {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "expected %s, got %s", Version, counterpartyVersion)
+	}
+
+	if err := im.keeper.scopedKeeper.ClaimCapability(ctx, chanCap, hostPortChannelCapabilityName(portID, channelID)); err != nil {
+		return "", errorsmod.Wrap(err, "claim channel capability")
+	}
+
+	return Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID, counterpartyVersion string) error {
+	if counterpartyVersion != Version {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "expected %s, got %s", Version, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface. Channels used by this
+// module may not be closed by user-initiated action.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "polkadotbridge channels cannot be closed")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. It decodes the incoming
+// transfer, validates the counterparty address via the configured
+// RelayerAdapter, and records the transfer as completed.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data types.Transfer
+	if err := im.keeper.cdc.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(err, "unmarshal packet data"))
+	}
+
+	if im.keeper.relayer != nil && !im.keeper.relayer.ValidateCounterpartyAddress(data.DestChain, data.Sender) {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid counterparty address"))
+	}
+
+	data.SourcePort = packet.GetDestPort()
+	data.SourceChannel = packet.GetDestChannel()
+	data.Sequence = packet.GetSequence()
+	data.Status = types.TransferStatus_TRANSFER_STATUS_COMPLETED
+
+	if err := im.keeper.SetCompletedTransfer(ctx, data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(err, "persist completed transfer"))
+	}
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It moves the
+// originating transfer from pending to completed or failed depending on the
+// acknowledgement outcome.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrap(err, "unmarshal acknowledgement")
+	}
+
+	transfer, found, err := im.keeper.GetPendingTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil {
+		return errorsmod.Wrap(err, "load pending transfer")
+	}
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no pending transfer for sequence %d", packet.GetSequence())
+	}
+
+	if ack.Success() {
+		transfer.Status = types.TransferStatus_TRANSFER_STATUS_COMPLETED
+	} else {
+		transfer.Status = types.TransferStatus_TRANSFER_STATUS_FAILED
+	}
+
+	if err := im.keeper.SetCompletedTransfer(ctx, transfer); err != nil {
+		return errorsmod.Wrap(err, "persist completed transfer")
+	}
+	return im.keeper.DeletePendingTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// OnTimeoutPacket implements the IBCModule interface. A timed-out transfer
+// moves from pending to a terminal timed-out state so it is never retried
+// automatically; callers must submit a fresh MsgInitiateTransfer.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	transfer, found, err := im.keeper.GetPendingTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil {
+		return errorsmod.Wrap(err, "load pending transfer")
+	}
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no pending transfer for sequence %d", packet.GetSequence())
+	}
+
+	transfer.Status = types.TransferStatus_TRANSFER_STATUS_TIMED_OUT
+	if err := im.keeper.SetCompletedTransfer(ctx, transfer); err != nil {
+		return errorsmod.Wrap(err, "persist timed-out transfer")
+	}
+	return im.keeper.DeletePendingTransfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// Version is the IBC version this module negotiates on channel handshakes.
+const Version = "polkadotbridge-1"
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// Keeper manages the polkadotbridge module's state: pending and completed
+// cross-chain transfers, keyed by the IBC channel and sequence that carried
+// them.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey store.KVStoreService
+
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  types.ScopedKeeper
+	relayer       types.RelayerAdapter
+
+	authority string
+}
+
+// NewKeeper constructs a new polkadotbridge Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey store.KVStoreService,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	relayer types.RelayerAdapter,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		relayer:       relayer,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-scoped logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority address (the address permitted
+// to execute governance-gated messages).
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetPendingTransfer stores a transfer that is awaiting acknowledgement or
+// timeout.
+func (k Keeper) SetPendingTransfer(ctx context.Context, transfer types.Transfer) error {
+	store := k.storeKey.OpenKVStore(ctx)
+	key := types.PendingTransferKey(transfer.SourcePort, transfer.SourceChannel, transfer.Sequence)
+	bz, err := k.cdc.Marshal(&transfer)
+	if err != nil {
+		return fmt.Errorf("marshal pending transfer: %w", err)
+	}
+	return store.Set(key, bz)
+}
+
+// GetPendingTransfer looks up a pending transfer by channel and sequence.
+func (k Keeper) GetPendingTransfer(ctx context.Context, portID, channelID string, sequence uint64) (types.Transfer, bool, error) {
+	store := k.storeKey.OpenKVStore(ctx)
+	key := types.PendingTransferKey(portID, channelID, sequence)
+	bz, err := store.Get(key)
+	if err != nil {
+		return types.Transfer{}, false, err
+	}
+	if bz == nil {
+		return types.Transfer{}, false, nil
+	}
+	var transfer types.Transfer
+	if err := k.cdc.Unmarshal(bz, &transfer); err != nil {
+		return types.Transfer{}, false, fmt.Errorf("unmarshal pending transfer: %w", err)
+	}
+	return transfer, true, nil
+}
+
+// DeletePendingTransfer removes a pending transfer, typically after it moves
+// to completed/failed/timed-out.
+func (k Keeper) DeletePendingTransfer(ctx context.Context, portID, channelID string, sequence uint64) error {
+	store := k.storeKey.OpenKVStore(ctx)
+	return store.Delete(types.PendingTransferKey(portID, channelID, sequence))
+}
+
+// SetCompletedTransfer records the final state of a transfer once it has
+// been acknowledged, has failed, or has timed out.
+func (k Keeper) SetCompletedTransfer(ctx context.Context, transfer types.Transfer) error {
+	store := k.storeKey.OpenKVStore(ctx)
+	key := types.CompletedTransferKey(transfer.SourcePort, transfer.SourceChannel, transfer.Sequence)
+	bz, err := k.cdc.Marshal(&transfer)
+	if err != nil {
+		return fmt.Errorf("marshal completed transfer: %w", err)
+	}
+	return store.Set(key, bz)
+}
+
+// GetCompletedTransfer looks up a completed transfer by channel and
+// sequence.
+func (k Keeper) GetCompletedTransfer(ctx context.Context, portID, channelID string, sequence uint64) (types.Transfer, bool, error) {
+	store := k.storeKey.OpenKVStore(ctx)
+	key := types.CompletedTransferKey(portID, channelID, sequence)
+	bz, err := store.Get(key)
+	if err != nil {
+		return types.Transfer{}, false, err
+	}
+	if bz == nil {
+		return types.Transfer{}, false, nil
+	}
+	var transfer types.Transfer
+	if err := k.cdc.Unmarshal(bz, &transfer); err != nil {
+		return types.Transfer{}, false, fmt.Errorf("unmarshal completed transfer: %w", err)
+	}
+	return transfer, true, nil
+}
+
+// iteratePrefix calls fn for every transfer stored under prefix, stopping
+// early if fn returns false.
+func (k Keeper) iteratePrefix(ctx context.Context, prefix []byte, fn func(types.Transfer) bool) error {
+	store := k.storeKey.OpenKVStore(ctx)
+	it, err := store.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var transfer types.Transfer
+		if err := k.cdc.Unmarshal(it.Value(), &transfer); err != nil {
+			return fmt.Errorf("unmarshal transfer during iteration: %w", err)
+		}
+		if !fn(transfer) {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePendingTransfers calls fn for every pending transfer.
+func (k Keeper) IteratePendingTransfers(ctx context.Context, fn func(types.Transfer) bool) error {
+	return k.iteratePrefix(ctx, types.PendingTransferKeyPrefix, fn)
+}
+
+// IterateCompletedTransfers calls fn for every completed transfer.
+func (k Keeper) IterateCompletedTransfers(ctx context.Context, fn func(types.Transfer) bool) error {
+	return k.iteratePrefix(ctx, types.CompletedTransferKeyPrefix, fn)
+}
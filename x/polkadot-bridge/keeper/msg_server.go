@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the polkadotbridge MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// InitiateTransfer implements types.MsgServer. It sends an IBC packet
+// carrying the payload over the sender's channel and records the transfer
+// as pending until it is acknowledged or times out.
+func (k msgServer) InitiateTransfer(goCtx context.Context, msg *types.MsgInitiateTransfer) (*types.MsgInitiateTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, hostPortChannelCapabilityName(msg.SourcePort, msg.SourceChannel))
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "channel capability not found")
+	}
+
+	packetData := types.Transfer{
+		SourcePort:    msg.SourcePort,
+		SourceChannel: msg.SourceChannel,
+		Sender:        msg.Sender,
+		DestChain:     msg.DestChain,
+		Payload:       msg.Payload,
+		Status:        types.TransferStatus_TRANSFER_STATUS_PENDING,
+	}
+
+	bz, err := k.cdc.Marshal(&packetData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal packet data")
+	}
+
+	sequence, err := k.channelKeeper.SendPacket(
+		ctx,
+		channelCap,
+		msg.SourcePort,
+		msg.SourceChannel,
+		clientHeightFromMsg(msg),
+		msg.TimeoutTimestamp,
+		bz,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "send IBC packet")
+	}
+
+	packetData.Sequence = sequence
+	if err := k.SetPendingTransfer(ctx, packetData); err != nil {
+		return nil, errorsmod.Wrap(err, "persist pending transfer")
+	}
+
+	return &types.MsgInitiateTransferResponse{Sequence: sequence}, nil
+}
+
+// AcknowledgeTransfer implements types.MsgServer. It is used by the relayer
+// adapter to resolve a pending transfer out-of-band, for counterparties that
+// cannot produce a standard IBC acknowledgement packet.
+func (k msgServer) AcknowledgeTransfer(goCtx context.Context, msg *types.MsgAcknowledgeTransfer) (*types.MsgAcknowledgeTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Relayer != k.authority && !k.relayer.IsAuthorizedRelayer(msg.Relayer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an authorized relayer", msg.Relayer)
+	}
+
+	transfer, found, err := k.GetPendingTransfer(ctx, types.PortID, msg.Channel, msg.Sequence)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load pending transfer")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no pending transfer for channel %s sequence %d", msg.Channel, msg.Sequence)
+	}
+
+	if msg.Success {
+		transfer.Status = types.TransferStatus_TRANSFER_STATUS_COMPLETED
+	} else {
+		transfer.Status = types.TransferStatus_TRANSFER_STATUS_FAILED
+	}
+
+	if err := k.SetCompletedTransfer(ctx, transfer); err != nil {
+		return nil, errorsmod.Wrap(err, "persist completed transfer")
+	}
+	if err := k.DeletePendingTransfer(ctx, types.PortID, msg.Channel, msg.Sequence); err != nil {
+		return nil, errorsmod.Wrap(err, "delete pending transfer")
+	}
+
+	return &types.MsgAcknowledgeTransferResponse{}, nil
+}
+
+func hostPortChannelCapabilityName(portID, channelID string) string {
+	return "capability/ports/" + portID + "/channels/" + channelID
+}
+
+func clientHeightFromMsg(msg *types.MsgInitiateTransfer) clienttypes.Height {
+	if msg.TimeoutHeight == 0 {
+		return clienttypes.ZeroHeight()
+	}
+	return clienttypes.NewHeight(0, msg.TimeoutHeight)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+	"io"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/x/polkadot-bridge/types"
+)
+
+// snapshotFormat is the only payload format the Snapshotter currently
+// writes; SupportedFormats also lists it so older snapshots stay restorable
+// across upgrades that don't change the encoding.
+const snapshotFormat = 1
+
+// Snapshotter streams the module's pending-transfer queue into state-sync
+// snapshots as an extension payload, modeled on wasmd's
+// x/wasm/keeper/snapshotter.go. Without this, a node restoring from a
+// snapshot would come up with no record of transfers that were in flight
+// over IBC at snapshot height, leaving them stuck until they time out.
+//
+// Unlike the keeper's own methods, ExtensionSnapshotter's SnapshotExtension
+// and RestoreExtension are called directly by the snapshot manager with
+// only a height, no sdk.Context, so the Snapshotter keeps its own handle on
+// the root multistore to build one.
+type Snapshotter struct {
+	keeper Keeper
+	cms    storetypes.CommitMultiStore
+}
+
+var _ snapshots.ExtensionSnapshotter = &Snapshotter{}
+
+// NewSnapshotter creates a new polkadotbridge Snapshotter for k, reading
+// and restoring pending transfers against cms.
+func NewSnapshotter(k Keeper, cms storetypes.CommitMultiStore) *Snapshotter {
+	return &Snapshotter{keeper: k, cms: cms}
+}
+
+// SnapshotName implements snapshots.ExtensionSnapshotter.
+func (s *Snapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements snapshots.ExtensionSnapshotter.
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return snapshotFormat
+}
+
+// SupportedFormats implements snapshots.ExtensionSnapshotter.
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{snapshotFormat}
+}
+
+// SnapshotExtension implements snapshots.ExtensionSnapshotter. It writes one
+// marshaled Transfer per payload chunk, so a very large pending queue never
+// needs to be held in memory all at once.
+func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshots.ExtensionPayloadWriter) error {
+	cacheMS, err := s.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return fmt.Errorf("load multistore at height %d: %w", height, err)
+	}
+	ctx := sdk.NewContext(cacheMS, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	var iterErr error
+	err = s.keeper.IteratePendingTransfers(ctx, func(transfer types.Transfer) bool {
+		bz, err := s.keeper.cdc.Marshal(&transfer)
+		if err != nil {
+			iterErr = fmt.Errorf("marshal pending transfer for snapshot: %w", err)
+			return false
+		}
+		if err := payloadWriter(bz); err != nil {
+			iterErr = fmt.Errorf("write pending transfer payload: %w", err)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return iterErr
+}
+
+// RestoreExtension implements snapshots.ExtensionSnapshotter. It reads back
+// the Transfer records SnapshotExtension wrote and re-inserts them as
+// pending transfers.
+func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
+	if format != snapshotFormat {
+		return fmt.Errorf("unsupported polkadotbridge snapshot extension format: %d", format)
+	}
+
+	// Unlike SnapshotExtension, restore writes into the multistore as it is
+	// being built rather than reading a previously committed version, so it
+	// uses s.cms directly instead of CacheMultiStoreWithVersion.
+	ctx := sdk.NewContext(s.cms, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read pending transfer payload: %w", err)
+		}
+
+		var transfer types.Transfer
+		if err := s.keeper.cdc.Unmarshal(payload, &transfer); err != nil {
+			return fmt.Errorf("unmarshal pending transfer from snapshot: %w", err)
+		}
+		if err := s.keeper.SetPendingTransfer(ctx, transfer); err != nil {
+			return fmt.Errorf("restore pending transfer: %w", err)
+		}
+	}
+}
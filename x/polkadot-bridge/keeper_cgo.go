@@ -0,0 +1,103 @@
+//go:build cgo && polkadot_ffi
+
+package polkadotbridge
+
+// This file requires both cgo and the polkadot_ffi build tag, not cgo alone:
+// Go sets the "cgo" tag automatically whenever CGO_ENABLED=1, which is the
+// default on most systems, regardless of whether libpolkadot_compat is
+// actually present at ./target/release. Gating on cgo alone would make this
+// file -- and its link-time dependency on a library most checkouts don't
+// have built -- the default, breaking `go build ./...` out of the box.
+// polkadot_ffi is an explicit opt-in for checkouts that have built the Rust
+// library: `go build -tags polkadot_ffi ./...`.
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+/*
+#cgo LDFLAGS: -L./target/release -lpolkadot_compat
+#include <stdint.h>
+#include <stdlib.h>
+
+// ffi_buffer is a length-prefixed, explicitly-owned byte buffer returned
+// across the FFI boundary. ptr is allocated by Rust and must be released
+// with free_ffi_buffer exactly once; cap may exceed len when Rust
+// over-allocated, and is reported so the Go side never has to guess it.
+typedef struct {
+	uint8_t* ptr;
+	uint32_t len;
+	uint32_t cap;
+} ffi_buffer;
+
+// Every call writes a ffi_buffer result and, in *err_code, one of the
+// ffiErrCode values defined in ffi.go: 0 ok, 1 invalid input, 2 the Rust
+// side recovered from a panic, 3 a result failed to encode. A non-zero
+// err_code means the returned ffi_buffer is empty and must not be read.
+extern ffi_buffer create_cross_chain_transaction(const uint8_t* req_ptr, uint32_t req_len, int32_t* err_code);
+extern void free_ffi_buffer(ffi_buffer buf);
+
+extern int32_t validate_cosmos_address(const uint8_t* ptr, uint32_t len);
+extern int32_t validate_polkadot_address(const uint8_t* ptr, uint32_t len);
+*/
+import "C"
+
+// CreateCrossChainTransaction creates a new cross-chain transaction by
+// marshaling req to protobuf bytes, passing them across the FFI boundary,
+// and decoding Rust's length-prefixed response buffer.
+func (b *PolkadotBridge) CreateCrossChainTransaction(sourceChain, destChain string, payload []byte) (*CrossChainTransaction, error) {
+	if len(payload) > maxFFIPayloadLen {
+		return nil, fmt.Errorf("payload of %d bytes exceeds max FFI payload length %d", len(payload), maxFFIPayloadLen)
+	}
+
+	req := &CrossChainTransactionRequest{
+		SourceChain: sourceChain,
+		DestChain:   destChain,
+		Payload:     payload,
+	}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal cross-chain transaction request: %w", err)
+	}
+
+	reqC := C.CBytes(reqBytes)
+	defer C.free(reqC)
+
+	var errCode C.int32_t
+	buf := C.create_cross_chain_transaction((*C.uint8_t)(reqC), C.uint32_t(len(reqBytes)), &errCode)
+
+	if ffiErrCode(errCode) != ffiOK {
+		return nil, fmt.Errorf("create_cross_chain_transaction failed: %s", ffiErrCode(errCode))
+	}
+	defer C.free_ffi_buffer(buf)
+
+	respBytes := C.GoBytes(unsafe.Pointer(buf.ptr), C.int(buf.len))
+
+	var tx CrossChainTransaction
+	if err := proto.Unmarshal(respBytes, &tx); err != nil {
+		return nil, fmt.Errorf("unmarshal cross-chain transaction response: %w", err)
+	}
+
+	return &tx, nil
+}
+
+// ValidateCosmosAddress validates a Cosmos address using the Rust
+// implementation.
+func (b *PolkadotBridge) ValidateCosmosAddress(address string) bool {
+	addrC := C.CBytes([]byte(address))
+	defer C.free(addrC)
+
+	return C.validate_cosmos_address((*C.uint8_t)(addrC), C.uint32_t(len(address))) != 0
+}
+
+// ValidatePolkadotAddress validates a Polkadot address using the Rust
+// implementation.
+func (b *PolkadotBridge) ValidatePolkadotAddress(address string) bool {
+	addrC := C.CBytes([]byte(address))
+	defer C.free(addrC)
+
+	return C.validate_polkadot_address((*C.uint8_t)(addrC), C.uint32_t(len(address))) != 0
+}
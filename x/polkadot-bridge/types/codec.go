@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the module's messages on the given
+// legacy amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgInitiateTransfer{}, "polkadotbridge/MsgInitiateTransfer", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeTransfer{}, "polkadotbridge/MsgAcknowledgeTransfer", nil)
+}
+
+// RegisterInterfaces registers the module's interface types with the given
+// interface registry.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgInitiateTransfer{},
+		&MsgAcknowledgeTransfer{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
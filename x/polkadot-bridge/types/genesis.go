@@ -0,0 +1,26 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default polkadotbridge genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PendingTransfers:   []Transfer{},
+		CompletedTransfers: []Transfer{},
+		PortId:             PortID,
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.PendingTransfers))
+	for _, t := range gs.PendingTransfers {
+		key := string(PendingTransferKey(t.SourcePort, t.SourceChannel, t.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending transfer for %s/%s sequence %d", t.SourcePort, t.SourceChannel, t.Sequence)
+		}
+		seen[key] = true
+	}
+	return nil
+}
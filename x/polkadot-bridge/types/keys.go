@@ -0,0 +1,39 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "polkadotbridge"
+
+	// StoreKey is the default store key for the module.
+	StoreKey = ModuleName
+
+	// PortID is the default port id the module binds to on InitGenesis.
+	PortID = ModuleName
+)
+
+// Key prefixes for the module's KVStore.
+var (
+	PendingTransferKeyPrefix   = []byte{0x01}
+	CompletedTransferKeyPrefix = []byte{0x02}
+	PortKey                    = []byte{0x03}
+)
+
+// TransferKey returns the store key for a transfer identified by the channel
+// it travelled on and the packet sequence it was sent with.
+func TransferKey(prefix []byte, portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s/%d", prefix, portID, channelID, sequence))
+}
+
+// PendingTransferKey returns the store key for a transfer awaiting
+// acknowledgement or timeout.
+func PendingTransferKey(portID, channelID string, sequence uint64) []byte {
+	return TransferKey(PendingTransferKeyPrefix, portID, channelID, sequence)
+}
+
+// CompletedTransferKey returns the store key for a transfer that has been
+// acknowledged, failed, or timed out.
+func CompletedTransferKey(portID, channelID string, sequence uint64) []byte {
+	return TransferKey(CompletedTransferKeyPrefix, portID, channelID, sequence)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgInitiateTransfer{}
+	_ sdk.Msg = &MsgAcknowledgeTransfer{}
+)
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgInitiateTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.SourcePort == "" || msg.SourceChannel == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "source port and channel are required")
+	}
+	if msg.DestChain == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "dest chain is required")
+	}
+	if len(msg.Payload) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payload cannot be empty")
+	}
+	if msg.TimeoutHeight == 0 && msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "either timeout height or timeout timestamp must be set")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgInitiateTransfer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgAcknowledgeTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid relayer address: %s", err)
+	}
+	if msg.Channel == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "channel is required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgAcknowledgeTransfer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
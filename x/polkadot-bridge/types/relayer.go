@@ -0,0 +1,28 @@
+package types
+
+// LightClientAdapter abstracts over how this module verifies state coming
+// from the Polkadot side of a channel. The default IBC path relies on the
+// counterparty light client registered with ibc-go's 02-client submodule,
+// but a chain can plug in an alternate adapter (e.g. a GRANDPA light client,
+// or during migration a bridge relying on trusted relayer signatures).
+type LightClientAdapter interface {
+	// ValidateCounterpartyAddress reports whether addr is a well-formed
+	// address on the Polkadot-side chain identified by chainID.
+	ValidateCounterpartyAddress(chainID, addr string) bool
+}
+
+// RelayerAdapter abstracts over the process that ferries packets between
+// this chain's IBC channel and the Polkadot side. It is only consulted for
+// out-of-band operations (see MsgAcknowledgeTransfer); ordinary transfers
+// flow entirely through the IBC packet lifecycle.
+type RelayerAdapter interface {
+	LightClientAdapter
+
+	// IsAuthorizedRelayer reports whether addr is allowed to call
+	// MsgAcknowledgeTransfer on behalf of this adapter. MsgAcknowledgeTransfer
+	// resolves a transfer out-of-band, without a counterparty-signed IBC
+	// acknowledgement packet to check, so this is the only thing standing
+	// between "the configured relayer observed completion on Polkadot" and
+	// "any signer can mark any transfer completed or failed."
+	IsAuthorizedRelayer(addr string) bool
+}